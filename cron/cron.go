@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 )
 
 // Expression represents a standard crontab expression and a command to
@@ -22,6 +23,26 @@ type Expression struct {
 	Months    []uint8
 	WeekDays  []uint8
 	Command   string
+
+	// Seconds is set when the expression was parsed by a Parser configured
+	// with the Second option, adding a leading seconds field ahead of
+	// Minutes.
+	Seconds []uint8
+
+	// EveryDuration is set instead of the fields above when the expression
+	// was an `@every <duration>` descriptor, which cannot be represented as
+	// the five calendar fields.
+	EveryDuration time.Duration
+
+	// MonthDaysWildcard and WeekDaysWildcard record whether the day-of-month
+	// and day-of-week fields were literally "*" (or the Quartz "?" synonym)
+	// rather than an explicit value set that merely happens to span the
+	// whole range. NewSchedule needs this distinction to apply Vixie cron's
+	// dom/dow OR-vs-AND rule correctly: an explicit "0-7" or "1-31" still
+	// counts as constrained and combines with OR, even though it expands to
+	// every value.
+	MonthDaysWildcard bool
+	WeekDaysWildcard  bool
 }
 
 // DumpFormatted pretty-prints expression to w.
@@ -29,36 +50,43 @@ func (e *Expression) DumpFormatted(w io.Writer) error {
 	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', tabwriter.TabIndent)
 	defer tw.Flush() // nolint:errcheck
 
-	rows := []struct {
+	if e.EveryDuration > 0 {
+		rows := []struct {
+			name  string
+			value string
+		}{
+			{name: "every", value: e.EveryDuration.String()},
+			{name: "command", value: e.Command},
+		}
+
+		for _, row := range rows {
+			if _, err := fmt.Fprintf(tw, "%s\t%s\n", row.name, row.value); err != nil {
+				return fmt.Errorf("could not write %s: %w", row.name, err)
+			}
+		}
+
+		return nil
+	}
+
+	type row struct {
 		name  string
 		value string
-	}{
-		{
-			name:  "minute",
-			value: join(e.Minutes),
-		},
-		{
-			name:  "hour",
-			value: join(e.Hours),
-		},
-		{
-			name:  "day of month",
-			value: join(e.MonthDays),
-		},
-		{
-			name:  "month",
-			value: join(e.Months),
-		},
-		{
-			name:  "day of week",
-			value: join(e.WeekDays),
-		},
-		{
-			name:  "command",
-			value: e.Command,
-		},
 	}
 
+	var rows []row
+	if e.Seconds != nil {
+		rows = append(rows, row{name: "second", value: join(e.Seconds)})
+	}
+
+	rows = append(rows,
+		row{name: "minute", value: join(e.Minutes)},
+		row{name: "hour", value: join(e.Hours)},
+		row{name: "day of month", value: join(e.MonthDays)},
+		row{name: "month", value: join(e.Months)},
+		row{name: "day of week", value: join(e.WeekDays)},
+		row{name: "command", value: e.Command},
+	)
+
 	for _, row := range rows {
 		if _, err := fmt.Fprintf(tw, "%s\t%s\n", row.name, row.value); err != nil {
 			return fmt.Errorf("could not write %s: %w", row.name, err)
@@ -80,10 +108,6 @@ func join(ss []uint8) string {
 	return sb.String()
 }
 
-// number of args in expression
-//
-// minutes hours monthdays months weekdays `command [args]`.
-const numExressionArgs = 6
 const (
 	minutesMin = 0
 	minutesMax = 59
@@ -101,37 +125,69 @@ const (
 	weekdaysMax = 6
 )
 
-// ParseExpression parses line in a valid cron expression.
-func ParseExpression(line string) (e Expression, err error) {
-	args := strings.SplitN(line, " ", numExressionArgs)
+// descriptors maps the standard crontab shorthand descriptors to their
+// equivalent five-field expression, matching the set supported by
+// robfig/cron.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
 
-	if e.Minutes, err = parseTime(args[0], minutesMin, minutesMax); err != nil {
-		return e, fmt.Errorf("invalid minutes arg: %w", err)
-	}
+// ParseExpression parses line as a standard five-field cron expression. It
+// also accepts the `@yearly`/`@monthly`/... descriptors and `@every
+// <duration>` in place of the five calendar fields.
+//
+// It is a thin wrapper around a Parser configured for the standard syntax;
+// use NewParser directly to enable a leading seconds field.
+func ParseExpression(line string) (Expression, error) {
+	return defaultParser.Parse(line)
+}
 
-	if e.Hours, err = parseTime(args[1], hoursMin, hoursMax); err != nil {
-		return e, fmt.Errorf("invalid hours arg: %w", err)
+// expandDescriptor replaces a leading `@yearly`/`@monthly`/... token with its
+// five-field equivalent, leaving the rest of line (the command) untouched.
+func expandDescriptor(line string) (string, error) {
+	fields := strings.SplitN(line, " ", 2)
+
+	expansion, ok := descriptors[strings.ToLower(fields[0])]
+	if !ok {
+		return "", fmt.Errorf("unknown descriptor %q", fields[0])
 	}
 
-	if e.MonthDays, err = parseTime(args[2], daysInMonthMin, daysInMonthMax); err != nil {
-		return e, fmt.Errorf("invalid monthdays arg: %w", err)
+	if len(fields) == 1 {
+		return expansion, nil
 	}
 
-	if e.Months, err = parseTime(args[3], monthsMin, monthsMax); err != nil {
-		return e, fmt.Errorf("invalid month arg: %w", err)
+	return expansion + " " + fields[1], nil
+}
+
+// parseEveryExpression parses an `@every <duration> <command>` line into an
+// Expression carrying EveryDuration, since a fixed duration cannot be
+// represented in the five bitmask fields.
+func parseEveryExpression(line string) (Expression, error) {
+	fields := strings.SplitN(line, " ", 3)
+	if len(fields) < 2 || fields[1] == "" {
+		return Expression{}, errors.New("expected duration after @every")
 	}
 
-	if e.WeekDays, err = parseTime(args[4], weekdaysMin, weekdaysMax); err != nil {
-		return e, fmt.Errorf("invalid weekdays arg: %w", err)
+	d, err := time.ParseDuration(fields[1])
+	if err != nil {
+		return Expression{}, fmt.Errorf("invalid @every duration: %w", err)
 	}
 
-	if args[5] == "" {
-		return e, errors.New("expected command but got an empty string")
+	if d <= 0 {
+		return Expression{}, errors.New("@every duration must be greater than 0")
 	}
 
-	e.Command = args[5]
+	if len(fields) < 3 || fields[2] == "" {
+		return Expression{}, errors.New("expected command but got an empty string")
+	}
 
-	return e, nil
+	return Expression{EveryDuration: d, Command: fields[2]}, nil
 }
 
 func parseTime(arg string, min, max uint8) ([]uint8, error) {
@@ -148,6 +204,13 @@ func parseTime(arg string, min, max uint8) ([]uint8, error) {
 		return result, nil
 	}
 
+	// Checked before "-" and "," since an interval's left side (e.g.
+	// "10-40/5") may itself contain a range.
+	intervals := strings.Split(arg, "/")
+	if len(intervals) > 1 {
+		return parseIntervals(intervals, min, max)
+	}
+
 	rnge := strings.Split(arg, "-")
 	if len(rnge) > 1 {
 		return parseRange(rnge, min, max)
@@ -158,11 +221,6 @@ func parseTime(arg string, min, max uint8) ([]uint8, error) {
 		return parseSteps(steps, min, max)
 	}
 
-	intervals := strings.Split(arg, "/")
-	if len(intervals) > 1 {
-		return parseIntervals(intervals, min, max)
-	}
-
 	exact, err := parseIntegral(arg, min, max)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse exact value: %w", err)
@@ -234,37 +292,56 @@ func parseSteps(steps []string, min, max uint8) ([]uint8, error) {
 	return result, nil
 }
 
+// parseIntervals parses the `<range-or-*>/<step>` syntax: the left side is
+// either "*" (meaning min..max), a bare integer n (meaning n..max), or an
+// `a-b` range, and the result is a, a+step, a+2*step, ..., up to and
+// including b.
 func parseIntervals(intervals []string, min, max uint8) ([]uint8, error) {
 	if len(intervals) != 2 {
 		return nil, errors.New("malformed intervals arg")
 	}
 
-	start := uint8(0)
-	if intervals[0] != "*" {
-		s, err := parseIntegral(intervals[0], min, max)
-		if err != nil {
-			return nil, fmt.Errorf("could not parse starting point: %w", err)
-		}
-		start = s
+	start, end, err := parseIntervalRange(intervals[0], min, max)
+	if err != nil {
+		return nil, err
 	}
 
-	every, err := parseIntegral(intervals[1], min, max)
+	step, err := strconv.ParseUint(intervals[1], 10, 8)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse repeated interval: %w", err)
 	}
 
-	if every == 0 {
+	if step == 0 {
 		return nil, errors.New("repeated interval must be greater than 0")
 	}
 
-	if (max+1)%every != 0 {
-		return nil, fmt.Errorf("invalid repeated interval: %d for %d", every, max+1)
-	}
-
-	result := make([]uint8, 0, (max+1)/every)
-	for i := start + min; i < max; i += every {
-		result = append(result, i)
+	result := make([]uint8, 0, (int(end)-int(start))/int(step)+1)
+	for i := int(start); i <= int(end); i += int(step) {
+		result = append(result, uint8(i))
 	}
 
 	return result, nil
 }
+
+// parseIntervalRange parses the left side of an `a-b/step` (or `*/step`, or
+// `n/step`) expression into its inclusive bounds.
+func parseIntervalRange(s string, min, max uint8) (start, end uint8, err error) {
+	switch {
+	case s == "*":
+		return min, max, nil
+
+	case strings.Contains(s, "-"):
+		rnge, err := parseRange(strings.Split(s, "-"), min, max)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not parse range: %w", err)
+		}
+		return rnge[0], rnge[len(rnge)-1], nil
+
+	default:
+		start, err := parseIntegral(s, min, max)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not parse starting point: %w", err)
+		}
+		return start, max, nil
+	}
+}
@@ -1,6 +1,9 @@
 package cron
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestParseIntegral(t *testing.T) {
 	cases := []struct {
@@ -286,6 +289,33 @@ func TestParseTime(t *testing.T) {
 				1, 16, 31, 46,
 			},
 		},
+		{
+			name:  "parse every 7 minutes (does not evenly divide 60)",
+			input: "*/7",
+			min:   0,
+			max:   59,
+			expected: []uint8{
+				0, 7, 14, 21, 28, 35, 42, 49, 56,
+			},
+		},
+		{
+			name:  "parse range with step",
+			input: "10-40/5",
+			min:   0,
+			max:   59,
+			expected: []uint8{
+				10, 15, 20, 25, 30, 35, 40,
+			},
+		},
+		{
+			name:  "parse range with step including endpoint",
+			input: "1-59/30",
+			min:   0,
+			max:   59,
+			expected: []uint8{
+				1, 31,
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -313,3 +343,168 @@ func TestParseTime(t *testing.T) {
 		})
 	}
 }
+
+func TestParseExpressionNames(t *testing.T) {
+	cases := []struct {
+		name     string
+		line     string
+		months   []uint8
+		weekDays []uint8
+	}{
+		{
+			name:     "month names",
+			line:     "0 0 1 JAN,feb,Mar * echo hi",
+			months:   []uint8{1, 2, 3},
+			weekDays: []uint8{0, 1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "weekday names",
+			line:     "0 0 * * mon-fri echo hi",
+			months:   []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			weekDays: []uint8{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "7 is sunday",
+			line:     "0 0 * * 7 echo hi",
+			months:   []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			weekDays: []uint8{0},
+		},
+		{
+			name:     "0-7 is every day, the common all-days idiom",
+			line:     "0 0 * * 0-7 echo hi",
+			months:   []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			weekDays: []uint8{0, 1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "1-7 also spans every day",
+			line:     "0 0 * * 1-7 echo hi",
+			months:   []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			weekDays: []uint8{0, 1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "6-7 is saturday and sunday",
+			line:     "0 0 * * 6-7 echo hi",
+			months:   []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			weekDays: []uint8{0, 6},
+		},
+		{
+			name:     "step through 7 folds into sunday",
+			line:     "0 0 * * */7 echo hi",
+			months:   []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			weekDays: []uint8{0},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := ParseExpression(c.line)
+			if err != nil {
+				t.Fatalf("could not parse expression: %v", err)
+			}
+
+			assertSameUint8s(t, "months", c.months, e.Months)
+			assertSameUint8s(t, "weekdays", c.weekDays, e.WeekDays)
+		})
+	}
+}
+
+// assertSameUint8s fails t if got doesn't hold the same values as want, in
+// order.
+func assertSameUint8s(t *testing.T, field string, want, got []uint8) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s mismatch: want %+v; got %+v", field, want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s mismatch: want %+v; got %+v", field, want, got)
+		}
+	}
+}
+
+// assertSameFields fails t unless got matches want across every field of
+// Expression, so a wrong descriptor expansion (e.g. the wrong weekday, or an
+// off-by-one day-of-month) can't slip past a test that only checks Command.
+func assertSameFields(t *testing.T, want, got Expression) {
+	t.Helper()
+
+	assertSameUint8s(t, "seconds", want.Seconds, got.Seconds)
+	assertSameUint8s(t, "minutes", want.Minutes, got.Minutes)
+	assertSameUint8s(t, "hours", want.Hours, got.Hours)
+	assertSameUint8s(t, "monthdays", want.MonthDays, got.MonthDays)
+	assertSameUint8s(t, "months", want.Months, got.Months)
+	assertSameUint8s(t, "weekdays", want.WeekDays, got.WeekDays)
+
+	if got.Command != want.Command {
+		t.Fatalf("command mismatch: want %q; got %q", want.Command, got.Command)
+	}
+	if got.EveryDuration != want.EveryDuration {
+		t.Fatalf("every duration mismatch: want %s; got %s", want.EveryDuration, got.EveryDuration)
+	}
+}
+
+func TestParseExpressionDescriptors(t *testing.T) {
+	cases := []struct {
+		name string
+		line string
+		want string
+	}{
+		{name: "yearly", line: "@yearly echo hi", want: "0 0 1 1 * echo hi"},
+		{name: "annually", line: "@annually echo hi", want: "0 0 1 1 * echo hi"},
+		{name: "monthly", line: "@monthly echo hi", want: "0 0 1 * * echo hi"},
+		{name: "weekly", line: "@weekly echo hi", want: "0 0 * * 0 echo hi"},
+		{name: "daily", line: "@daily echo hi", want: "0 0 * * * echo hi"},
+		{name: "midnight", line: "@midnight echo hi", want: "0 0 * * * echo hi"},
+		{name: "hourly", line: "@hourly echo hi", want: "0 * * * * echo hi"},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			want, err := ParseExpression(c.want)
+			if err != nil {
+				t.Fatalf("could not parse expected expression: %v", err)
+			}
+
+			got, err := ParseExpression(c.line)
+			if err != nil {
+				t.Fatalf("could not parse descriptor: %v", err)
+			}
+
+			assertSameFields(t, want, got)
+		})
+	}
+
+	if _, err := ParseExpression("@unknown echo hi"); err == nil {
+		t.Fatal("expected error for unknown descriptor")
+	}
+}
+
+func TestParseExpressionEvery(t *testing.T) {
+	e, err := ParseExpression("@every 1h30m echo hi")
+	if err != nil {
+		t.Fatalf("could not parse @every expression: %v", err)
+	}
+
+	if e.EveryDuration != 90*time.Minute {
+		t.Fatalf("duration mismatch: want %s; got %s", 90*time.Minute, e.EveryDuration)
+	}
+
+	if e.Command != "echo hi" {
+		t.Fatalf("command mismatch: want %q; got %q", "echo hi", e.Command)
+	}
+
+	if _, err := ParseExpression("@every notaduration echo hi"); err == nil {
+		t.Fatal("expected error for invalid duration")
+	}
+
+	if _, err := ParseExpression("@every 1h"); err == nil {
+		t.Fatal("expected error for missing command")
+	}
+}
@@ -0,0 +1,113 @@
+package cron
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Entry is a single schedule parsed from a crontab, annotated with the line
+// it came from so callers can report errors against the source file.
+type Entry struct {
+	Line       int
+	Expression Expression
+}
+
+// envAssignment matches a crontab `KEY=VALUE` environment line.
+var envAssignment = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\s*=\s*(.*)$`)
+
+// ParseCrontab reads a Vixie-style crontab from r: blank lines and `#`
+// comments are skipped, `KEY=VALUE` lines are collected into the returned
+// env map, and every other line is parsed with ParseExpression.
+func ParseCrontab(r io.Reader) ([]Entry, map[string]string, error) {
+	env := make(map[string]string)
+	var entries []Entry
+
+	scanner := bufio.NewScanner(r)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if m := envAssignment.FindStringSubmatch(line); m != nil {
+			env[m[1]] = unquote(m[2])
+			continue
+		}
+
+		expr, err := ParseExpression(line)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+
+		entries = append(entries, Entry{Line: lineNo, Expression: expr})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("could not read crontab: %w", err)
+	}
+
+	return entries, env, nil
+}
+
+// ParseCrontabFile is a convenience wrapper around ParseCrontab that reads
+// from the file at path.
+func ParseCrontabFile(path string) ([]Entry, map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not open crontab file: %w", err)
+	}
+	defer f.Close() // nolint:errcheck
+
+	return ParseCrontab(f)
+}
+
+// unquote strips a single matching pair of surrounding single or double
+// quotes from s, as crontab env assignments allow.
+func unquote(s string) string {
+	if len(s) < 2 {
+		return s
+	}
+
+	first, last := s[0], s[len(s)-1]
+	if (first == '"' || first == '\'') && first == last {
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
+
+// RegisterEntries schedules job to run for every parsed entry, passing it
+// the entry's command when its schedule fires, and returns the assigned IDs
+// in the same order as entries. If any entry can't be registered, none are:
+// it validates all of entries up front so a failure never leaves a partial
+// registration running.
+func (c *Cron) RegisterEntries(entries []Entry, job func(command string)) ([]ID, error) {
+	schedules := make([]*Schedule, len(entries))
+	for i, entry := range entries {
+		if entry.Expression.EveryDuration > 0 {
+			return nil, fmt.Errorf("line %d: @every entries are not yet supported by Cron", entry.Line)
+		}
+
+		schedule, err := NewSchedule(entry.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", entry.Line, err)
+		}
+		schedules[i] = schedule
+	}
+
+	ids := make([]ID, 0, len(entries))
+	for i, entry := range entries {
+		entry := entry
+		ids = append(ids, c.addEntry(schedules[i], func() { job(entry.Expression.Command) }))
+	}
+
+	return ids, nil
+}
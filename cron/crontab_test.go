@@ -0,0 +1,83 @@
+package cron
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCrontab(t *testing.T) {
+	input := `# a comment
+FOO=bar
+BAZ="quoted value"
+
+0 0 * * * echo hi
+@hourly echo tick
+`
+
+	entries, env, err := ParseCrontab(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("could not parse crontab: %v", err)
+	}
+
+	if env["FOO"] != "bar" {
+		t.Fatalf("FOO mismatch: want %q; got %q", "bar", env["FOO"])
+	}
+	if env["BAZ"] != "quoted value" {
+		t.Fatalf("BAZ mismatch: want %q; got %q", "quoted value", env["BAZ"])
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries; got %d", len(entries))
+	}
+
+	if entries[0].Line != 5 {
+		t.Fatalf("expected first entry on line 5; got %d", entries[0].Line)
+	}
+	if entries[0].Expression.Command != "echo hi" {
+		t.Fatalf("unexpected command: %q", entries[0].Expression.Command)
+	}
+
+	if entries[1].Line != 6 {
+		t.Fatalf("expected second entry on line 6; got %d", entries[1].Line)
+	}
+	if entries[1].Expression.Command != "echo tick" {
+		t.Fatalf("unexpected command: %q", entries[1].Expression.Command)
+	}
+}
+
+func TestParseCrontabReportsLineOnError(t *testing.T) {
+	input := "0 0 * * * echo hi\nnot a valid line\n"
+
+	_, _, err := ParseCrontab(strings.NewReader(input))
+	if err == nil {
+		t.Fatal("expected a parse error")
+	}
+
+	if !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected error to mention line 2, got: %v", err)
+	}
+}
+
+func TestRegisterEntries(t *testing.T) {
+	entries, _, err := ParseCrontab(strings.NewReader("* * * * * run-me\n"))
+	if err != nil {
+		t.Fatalf("could not parse crontab: %v", err)
+	}
+
+	c := New()
+
+	commands := make(chan string, 1)
+	if _, err := c.RegisterEntries(entries, func(command string) { commands <- command }); err != nil {
+		t.Fatalf("could not register entries: %v", err)
+	}
+
+	if len(c.entries) != 1 {
+		t.Fatalf("expected 1 registered entry; got %d", len(c.entries))
+	}
+
+	c.entries[0].job()
+
+	if got := <-commands; got != "run-me" {
+		t.Fatalf("command mismatch: want %q; got %q", "run-me", got)
+	}
+}
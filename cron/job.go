@@ -0,0 +1,139 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Job is a unit of work a Cron can run on a schedule.
+type Job interface {
+	Run(ctx context.Context) error
+}
+
+// Logger receives the start/finish/error events a Job emits. *log.Logger
+// satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...interface{}) {}
+
+type jobConfig struct {
+	logger    Logger
+	retries   int
+	backoff   time.Duration
+	timeout   time.Duration
+	singleton bool
+}
+
+// JobOption configures how RegisterJob runs a Job.
+type JobOption func(*jobConfig)
+
+// WithLogger logs start/finish/error events to logger instead of discarding
+// them.
+func WithLogger(logger Logger) JobOption {
+	return func(c *jobConfig) {
+		c.logger = logger
+	}
+}
+
+// WithRetry reruns a failing job up to n additional times, waiting backoff
+// between attempts.
+func WithRetry(n int, backoff time.Duration) JobOption {
+	return func(c *jobConfig) {
+		c.retries = n
+		c.backoff = backoff
+	}
+}
+
+// WithTimeout cancels the context passed to Job.Run after d.
+func WithTimeout(d time.Duration) JobOption {
+	return func(c *jobConfig) {
+		c.timeout = d
+	}
+}
+
+// WithSingleton skips a tick if the previous run of the job is still in
+// progress, instead of running it concurrently.
+func WithSingleton() JobOption {
+	return func(c *jobConfig) {
+		c.singleton = true
+	}
+}
+
+// jobRunner wraps a Job with the behavior configured by a set of JobOptions.
+type jobRunner struct {
+	job     Job
+	cfg     jobConfig
+	running int32
+}
+
+func newJobRunner(job Job, opts ...JobOption) *jobRunner {
+	cfg := jobConfig{logger: noopLogger{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return &jobRunner{job: job, cfg: cfg}
+}
+
+// run executes the job, recovering panics and applying retries, timeout and
+// singleton mode, logging start/finish/error events alongside fired (the
+// time the schedule matched) and next (when it will next fire).
+func (r *jobRunner) run(fired, next time.Time) {
+	if r.cfg.singleton {
+		if !atomic.CompareAndSwapInt32(&r.running, 0, 1) {
+			r.cfg.logger.Printf("job skipped, previous run still in progress: fired=%s next=%s", fired, next)
+			return
+		}
+		defer atomic.StoreInt32(&r.running, 0)
+	}
+
+	r.cfg.logger.Printf("job starting: fired=%s next=%s", fired, next)
+
+	if err := r.runWithRetry(); err != nil {
+		r.cfg.logger.Printf("job failed: fired=%s next=%s err=%v", fired, next, err)
+		return
+	}
+
+	r.cfg.logger.Printf("job finished: fired=%s next=%s", fired, next)
+}
+
+func (r *jobRunner) runWithRetry() error {
+	var err error
+	for attempt := 0; attempt <= r.cfg.retries; attempt++ {
+		if attempt > 0 {
+			if r.cfg.backoff > 0 {
+				time.Sleep(r.cfg.backoff)
+			}
+			r.cfg.logger.Printf("job retrying: attempt=%d", attempt)
+		}
+
+		if err = r.runOnce(); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+func (r *jobRunner) runOnce() (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v", p)
+		}
+	}()
+
+	ctx := context.Background()
+	if r.cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.cfg.timeout)
+		defer cancel()
+	}
+
+	return r.job.Run(ctx)
+}
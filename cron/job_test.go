@@ -0,0 +1,96 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeLogger struct {
+	mu   sync.Mutex
+	logs []string
+}
+
+func (l *fakeLogger) Printf(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.logs = append(l.logs, format)
+}
+
+type jobFunc func(ctx context.Context) error
+
+func (f jobFunc) Run(ctx context.Context) error { return f(ctx) }
+
+func TestJobRunnerRecoversPanic(t *testing.T) {
+	logger := &fakeLogger{}
+	runner := newJobRunner(jobFunc(func(context.Context) error {
+		panic("boom")
+	}), WithLogger(logger))
+
+	runner.run(time.Now(), time.Now())
+
+	if len(logger.logs) == 0 {
+		t.Fatal("expected panic to be logged, got no logs")
+	}
+
+	if last := logger.logs[len(logger.logs)-1]; !strings.HasPrefix(last, "job failed") {
+		t.Fatalf("expected panic to be logged as a job failure, got %q", last)
+	}
+}
+
+func TestJobRunnerRetries(t *testing.T) {
+	var attempts int32
+	runner := newJobRunner(jobFunc(func(context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("not yet")
+		}
+		return nil
+	}), WithRetry(2, time.Millisecond))
+
+	runner.run(time.Now(), time.Now())
+
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts; got %d", attempts)
+	}
+}
+
+func TestJobRunnerSingletonSkipsOverlappingRun(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var runs int32
+	runner := newJobRunner(jobFunc(func(context.Context) error {
+		runs++
+		close(started)
+		<-release
+		return nil
+	}), WithSingleton())
+
+	go runner.run(time.Now(), time.Now())
+	<-started
+
+	runner.run(time.Now(), time.Now())
+	close(release)
+
+	if runs != 1 {
+		t.Fatalf("expected the overlapping run to be skipped; got %d runs", runs)
+	}
+}
+
+func TestJobRunnerTimeout(t *testing.T) {
+	runner := newJobRunner(jobFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}), WithTimeout(10*time.Millisecond))
+
+	start := time.Now()
+	runner.run(time.Now(), time.Now())
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected job to be cancelled quickly, took %s", elapsed)
+	}
+}
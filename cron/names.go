@@ -0,0 +1,97 @@
+package cron
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// monthNames maps the case-insensitive month abbreviations accepted in the
+// months field to their numeric value, mirroring the bounds{names: ...}
+// lookup tables robfig/cron uses.
+var monthNames = map[string]uint8{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+// weekdayNames maps the case-insensitive weekday abbreviations accepted in
+// the weekdays field to their numeric value.
+var weekdayNames = map[string]uint8{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// parseWeekdays parses the weekdays field, accepting 7 as a synonym for
+// Sunday (0) alongside the names in weekdayNames. Unlike a text substitution
+// of "7" for "0" before parsing, 7 is allowed through parseTime as a value in
+// its own right and only folded into 0 afterwards, so it combines correctly
+// with ranges and steps that span it (e.g. "0-7", "6-7", "*/7").
+func parseWeekdays(arg string) ([]uint8, error) {
+	if arg == "*" {
+		return parseTime(arg, weekdaysMin, weekdaysMax)
+	}
+
+	values, err := parseTime(arg, weekdaysMin, 7)
+	if err != nil {
+		return nil, err
+	}
+
+	return foldSundaySeven(values), nil
+}
+
+// foldSundaySeven maps 7 to 0 in values, dedupes, and returns the result in
+// ascending order.
+func foldSundaySeven(values []uint8) []uint8 {
+	seen := make(map[uint8]struct{}, len(values))
+	result := make([]uint8, 0, len(values))
+
+	for _, v := range values {
+		if v == 7 {
+			v = 0
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result
+}
+
+// substituteNames replaces case-insensitive name tokens in arg (e.g. "MON",
+// "jan") with their numeric value from names, leaving everything else (digits
+// and the -,/* separators) untouched.
+func substituteNames(arg string, names map[string]uint8) string {
+	var sb strings.Builder
+
+	i := 0
+	for i < len(arg) {
+		c := arg[i]
+		if !isAlpha(c) {
+			sb.WriteByte(c)
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(arg) && isAlpha(arg[j]) {
+			j++
+		}
+
+		token := arg[i:j]
+		if v, ok := names[strings.ToUpper(token)]; ok {
+			sb.WriteString(strconv.Itoa(int(v)))
+		} else {
+			sb.WriteString(token)
+		}
+		i = j
+	}
+
+	return sb.String()
+}
+
+func isAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,157 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParserOption configures which fields a Parser accepts, mirroring the
+// configurable-parser pattern used by robfig/cron. Only Second, DowOptional
+// and Descriptor change what Parse accepts; the minute, hour, day-of-month,
+// month and day-of-week fields are always parsed, whether or not Minute,
+// Hour, Dom, Month and Dow are set.
+type ParserOption uint8
+
+const (
+	Second ParserOption = 1 << iota
+	Minute
+	Hour
+	Dom
+	Month
+	Dow
+	// DowOptional allows the day-of-week field to be omitted entirely, in
+	// which case it defaults to "*". Because the trailing command can itself
+	// contain spaces, an omitted dow is only detected when doing so is
+	// unambiguous (the line has exactly one fewer token than the full
+	// field count); a multi-word command otherwise resolves as if dow were
+	// present.
+	DowOptional
+	// Descriptor enables the `@yearly`/`@monthly`/... and `@every` shorthand.
+	Descriptor
+)
+
+const (
+	secondsMin = 0
+	secondsMax = 59
+)
+
+// Parser parses cron expressions according to a configured set of
+// ParserOptions. ParseExpression is a thin wrapper around a Parser
+// configured for the standard five-field syntax.
+type Parser struct {
+	options ParserOption
+}
+
+// defaultParser backs the package-level ParseExpression.
+var defaultParser = NewParser(Minute | Hour | Dom | Month | Dow | Descriptor)
+
+// NewParser builds a Parser configured with options.
+func NewParser(options ParserOption) *Parser {
+	return &Parser{options: options}
+}
+
+// Parse parses line according to p's configured options.
+func (p *Parser) Parse(line string) (e Expression, err error) {
+	if p.options&Descriptor != 0 {
+		if strings.HasPrefix(line, "@every ") {
+			return parseEveryExpression(line)
+		}
+
+		if strings.HasPrefix(line, "@") {
+			if line, err = expandDescriptor(line); err != nil {
+				return e, err
+			}
+		}
+	}
+
+	hasSecond := p.options&Second != 0
+	dowOptional := p.options&DowOptional != 0
+
+	numFields := 5
+	if hasSecond {
+		numFields++
+	}
+
+	// Disambiguating an omitted day-of-week field from a present one is only
+	// possible by counting whitespace-separated tokens up front: prefer the
+	// full field count whenever there are enough tokens for it, and only
+	// fall back to the day-of-week-omitted form when there are exactly
+	// enough tokens for that (and no more).
+	total := len(strings.Fields(line))
+
+	effectiveFields := numFields
+	if dowOptional && total == numFields {
+		effectiveFields = numFields - 1
+	} else if total < numFields+1 {
+		return e, fmt.Errorf("expected %d fields but got %d", numFields+1, total)
+	}
+
+	args := strings.SplitN(line, " ", effectiveFields+1)
+	if len(args) != effectiveFields+1 {
+		return e, fmt.Errorf("expected %d fields but got %d", effectiveFields+1, len(args))
+	}
+
+	if effectiveFields == numFields-1 {
+		// Day-of-week was omitted; default it to "*" ahead of the command.
+		command := args[len(args)-1]
+		args = append(args[:len(args)-1], "*", command)
+	}
+
+	i := 0
+	if hasSecond {
+		if e.Seconds, err = parseTime(args[i], secondsMin, secondsMax); err != nil {
+			return e, fmt.Errorf("invalid seconds arg: %w", err)
+		}
+		i++
+	}
+
+	if e.Minutes, err = parseTime(args[i], minutesMin, minutesMax); err != nil {
+		return e, fmt.Errorf("invalid minutes arg: %w", err)
+	}
+	i++
+
+	if e.Hours, err = parseTime(args[i], hoursMin, hoursMax); err != nil {
+		return e, fmt.Errorf("invalid hours arg: %w", err)
+	}
+	i++
+
+	domArg, dowArg := resolveDowDomPlaceholders(args[i], args[i+2])
+	e.MonthDaysWildcard = domArg == "*"
+	e.WeekDaysWildcard = dowArg == "*"
+
+	if e.MonthDays, err = parseTime(domArg, daysInMonthMin, daysInMonthMax); err != nil {
+		return e, fmt.Errorf("invalid monthdays arg: %w", err)
+	}
+	i++
+
+	if e.Months, err = parseTime(substituteNames(args[i], monthNames), monthsMin, monthsMax); err != nil {
+		return e, fmt.Errorf("invalid month arg: %w", err)
+	}
+	i++
+
+	if e.WeekDays, err = parseWeekdays(substituteNames(dowArg, weekdayNames)); err != nil {
+		return e, fmt.Errorf("invalid weekdays arg: %w", err)
+	}
+	i++
+
+	if args[i] == "" {
+		return e, errors.New("expected command but got an empty string")
+	}
+	e.Command = args[i]
+
+	return e, nil
+}
+
+// resolveDowDomPlaceholders handles the Quartz-style "?" synonym for "*" in
+// the day-of-month and day-of-week fields: "?" means "no specific value" for
+// that field.
+func resolveDowDomPlaceholders(dom, dow string) (string, string) {
+	if dom == "?" {
+		dom = "*"
+	}
+	if dow == "?" {
+		dow = "*"
+	}
+	return dom, dow
+}
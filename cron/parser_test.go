@@ -0,0 +1,66 @@
+package cron
+
+import "testing"
+
+func TestParserSeconds(t *testing.T) {
+	p := NewParser(Second | Minute | Hour | Dom | Month | Dow)
+
+	e, err := p.Parse("*/15 0 0 * * * echo hi")
+	if err != nil {
+		t.Fatalf("could not parse expression: %v", err)
+	}
+
+	if len(e.Seconds) != 4 || e.Seconds[0] != 0 || e.Seconds[3] != 45 {
+		t.Fatalf("unexpected seconds: %+v", e.Seconds)
+	}
+
+	if e.Command != "echo hi" {
+		t.Fatalf("command mismatch: want %q; got %q", "echo hi", e.Command)
+	}
+}
+
+func TestParserDowOptional(t *testing.T) {
+	p := NewParser(Minute | Hour | Dom | Month | Dow | DowOptional)
+
+	e, err := p.Parse("0 0 1 1 runbackup")
+	if err != nil {
+		t.Fatalf("could not parse expression with omitted dow: %v", err)
+	}
+
+	if len(e.WeekDays) != 7 {
+		t.Fatalf("expected omitted dow to default to *, got %+v", e.WeekDays)
+	}
+
+	if e.Command != "runbackup" {
+		t.Fatalf("command mismatch: want %q; got %q", "runbackup", e.Command)
+	}
+
+	full, err := p.Parse("0 0 1 1 0 runbackup")
+	if err != nil {
+		t.Fatalf("could not parse expression with explicit dow: %v", err)
+	}
+
+	if len(full.WeekDays) != 1 || full.WeekDays[0] != 0 {
+		t.Fatalf("unexpected weekdays: %+v", full.WeekDays)
+	}
+}
+
+func TestParseExpressionQuestionMark(t *testing.T) {
+	e, err := ParseExpression("0 0 ? * 1 echo hi")
+	if err != nil {
+		t.Fatalf("could not parse expression: %v", err)
+	}
+
+	if len(e.MonthDays) != 31 {
+		t.Fatalf("expected ? in dom to mean *, got %+v", e.MonthDays)
+	}
+
+	e, err = ParseExpression("0 0 1 * ? echo hi")
+	if err != nil {
+		t.Fatalf("could not parse expression: %v", err)
+	}
+
+	if len(e.WeekDays) != 7 {
+		t.Fatalf("expected ? in dow to mean *, got %+v", e.WeekDays)
+	}
+}
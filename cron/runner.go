@@ -0,0 +1,223 @@
+package cron
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ID identifies a job registered with a Cron runner.
+type ID uint64
+
+// entry pairs a compiled schedule with the job it fires and the next time it
+// is due.
+type entry struct {
+	id       ID
+	schedule *Schedule
+	job      func()
+	next     time.Time
+}
+
+// Cron runs registered jobs in their own goroutines whenever their schedule
+// matches.
+type Cron struct {
+	mu      sync.Mutex
+	entries []*entry
+	nextID  ID
+
+	// wake interrupts Start's wait so it can pick up an entries change.
+	// It's buffered so addEntry/Remove never block on it, including across
+	// a concurrent Stop or ctx cancellation.
+	wake chan struct{}
+	stop chan struct{}
+	done chan struct{}
+
+	running  bool
+	location *time.Location
+	now      func() time.Time
+}
+
+// Option configures a Cron built by New.
+type Option func(*Cron)
+
+// WithLocation evaluates schedules in loc instead of the local time zone.
+func WithLocation(loc *time.Location) Option {
+	return func(c *Cron) {
+		c.location = loc
+	}
+}
+
+// New creates an idle Cron runner. Call Start to begin firing jobs.
+func New(opts ...Option) *Cron {
+	c := &Cron{
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		location: time.Local,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.now = func() time.Time { return time.Now().In(c.location) }
+
+	return c
+}
+
+// Register compiles expr and schedules job to run whenever it matches,
+// returning an ID that can later be passed to Remove.
+func (c *Cron) Register(expr string, job func()) (ID, error) {
+	schedule, err := ParseSchedule(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.addEntry(schedule, job), nil
+}
+
+// RegisterJob compiles expr and schedules job to run whenever it matches,
+// wrapping it with logging, panic recovery, retries, timeouts and singleton
+// skipping as configured by opts.
+func (c *Cron) RegisterJob(expr string, job Job, opts ...JobOption) (ID, error) {
+	schedule, err := ParseSchedule(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	runner := newJobRunner(job, opts...)
+
+	return c.addEntry(schedule, func() {
+		fired := c.now()
+		runner.run(fired, schedule.Next(fired))
+	}), nil
+}
+
+// addEntry assigns job the next ID, appends it under lock, and wakes Start
+// (if running) so it can pick up the change.
+func (c *Cron) addEntry(schedule *Schedule, job func()) ID {
+	next := schedule.Next(c.now())
+
+	c.mu.Lock()
+	c.nextID++
+	id := c.nextID
+
+	c.entries = append(c.entries, &entry{
+		id:       id,
+		schedule: schedule,
+		job:      job,
+		next:     next,
+	})
+	c.mu.Unlock()
+
+	c.notify()
+
+	return id
+}
+
+// Remove stops id from firing. It is a no-op if id is unknown.
+func (c *Cron) Remove(id ID) {
+	c.mu.Lock()
+	c.entries = removeEntry(c.entries, id)
+	c.mu.Unlock()
+
+	c.notify()
+}
+
+// notify wakes a running Start loop without ever blocking: if a wakeup is
+// already pending, this one is redundant.
+func (c *Cron) notify() {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start begins firing registered jobs until ctx is done or Stop is called.
+// It blocks until the run loop exits.
+func (c *Cron) Start(ctx context.Context) {
+	c.mu.Lock()
+	c.running = true
+	c.done = make(chan struct{})
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.running = false
+		close(c.done)
+		c.mu.Unlock()
+	}()
+
+	for {
+		c.mu.Lock()
+		sort.Slice(c.entries, func(i, j int) bool {
+			return c.entries[i].next.Before(c.entries[j].next)
+		})
+
+		wait := yearsAheadDuration
+		if len(c.entries) > 0 {
+			wait = c.entries[0].next.Sub(c.now())
+		}
+		c.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case now := <-timer.C:
+			c.mu.Lock()
+			for _, e := range c.entries {
+				if e.next.After(now) {
+					break
+				}
+				go e.job()
+				e.next = e.schedule.Next(now)
+			}
+			c.mu.Unlock()
+
+		case <-c.wake:
+			timer.Stop()
+
+		case <-c.stop:
+			timer.Stop()
+			return
+
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// Stop halts a running Cron. It is safe to call even if Start has not been
+// called yet, and never blocks even if Start exits concurrently (e.g. via
+// ctx cancellation) right as Stop is called.
+func (c *Cron) Stop() {
+	c.mu.Lock()
+	running := c.running
+	done := c.done
+	c.mu.Unlock()
+
+	if !running {
+		return
+	}
+
+	select {
+	case c.stop <- struct{}{}:
+	case <-done:
+	}
+
+	<-done
+}
+
+// yearsAheadDuration is how long Start idles when there are no registered
+// entries, re-checking periodically in case one is added.
+const yearsAheadDuration = time.Hour
+
+func removeEntry(entries []*entry, id ID) []*entry {
+	for i, e := range entries {
+		if e.id == id {
+			return append(entries[:i], entries[i+1:]...)
+		}
+	}
+	return entries
+}
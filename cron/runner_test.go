@@ -0,0 +1,162 @@
+package cron
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCronRunsRegisteredJob(t *testing.T) {
+	c := New()
+
+	fired := make(chan struct{}, 1)
+	if _, err := c.Register("* * * * *", func() { fired <- struct{}{} }); err != nil {
+		t.Fatalf("could not register: %v", err)
+	}
+	c.entries[0].next = c.now()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		c.Start(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("job never fired")
+	}
+
+	cancel()
+	<-done
+}
+
+func TestCronRemoveStopsFiring(t *testing.T) {
+	c := New()
+
+	fired := make(chan struct{}, 8)
+	id, err := c.Register("* * * * *", func() { fired <- struct{}{} })
+	if err != nil {
+		t.Fatalf("could not register: %v", err)
+	}
+	c.entries[0].next = c.now()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		c.Start(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("job never fired before removal")
+	}
+
+	c.Remove(id)
+
+	for {
+		c.mu.Lock()
+		empty := len(c.entries) == 0
+		c.mu.Unlock()
+		if empty {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// Drain anything already in flight, then confirm nothing new arrives.
+	drain := true
+	for drain {
+		select {
+		case <-fired:
+		default:
+			drain = false
+		}
+	}
+
+	select {
+	case <-fired:
+		t.Fatal("job fired after being removed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cancel()
+	<-done
+}
+
+func TestCronStopReturnsAfterStartExits(t *testing.T) {
+	c := New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		c.Start(ctx)
+		close(done)
+	}()
+
+	// Give Start a moment to reach its select loop before stopping it.
+	time.Sleep(10 * time.Millisecond)
+
+	c.Stop()
+
+	select {
+	case <-done:
+	default:
+		t.Fatal("Stop returned before Start exited")
+	}
+}
+
+// TestCronRegisterRaceDuringShutdown reproduces the reviewed deadlock:
+// Register/Remove used to block on a channel only Start's loop drained, so a
+// call landing just as Start exits (ctx cancellation or a concurrent Stop)
+// could hang forever. addEntry/Remove no longer block on anything Start
+// owns, so this must complete well within the test's own timeout.
+func TestCronRegisterRaceDuringShutdown(t *testing.T) {
+	c := New()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.Start(ctx)
+	}()
+
+	var registerWG sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		registerWG.Add(1)
+		go func() {
+			defer registerWG.Done()
+			id, err := c.Register("* * * * *", func() {})
+			if err == nil {
+				c.Remove(id)
+			}
+		}()
+	}
+
+	registerDone := make(chan struct{})
+	go func() {
+		registerWG.Wait()
+		close(registerDone)
+	}()
+
+	select {
+	case <-registerDone:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Register/Remove hung racing Start's shutdown")
+	}
+
+	wg.Wait()
+}
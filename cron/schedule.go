@@ -0,0 +1,189 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// starBit marks a field as unconstrained (parsed from "*"), so that
+// Schedule.dayMatches can tell the Vixie-cron OR combination of day-of-month
+// and day-of-week apart from the usual AND combination of every other field.
+const starBit uint64 = 1 << 63
+
+// Schedule is a compiled cron expression. Each field is stored as a bitset
+// (bit i set means "value i matches"), which keeps Next and Prev to a single
+// AND per field instead of scanning slices.
+type Schedule struct {
+	Minute, Hour, Dom, Month, Dow uint64
+}
+
+// NewSchedule builds a Schedule from a parsed Expression. It rejects an
+// Expression with a non-nil Seconds field: Schedule (and Next/Prev) only
+// operate at minute granularity, so a seconds constraint from a Parser
+// built with the Second option would otherwise be silently dropped.
+func NewSchedule(e Expression) (*Schedule, error) {
+	if len(e.Seconds) > 0 {
+		return nil, errors.New("seconds are not representable as a Schedule, which only resolves to minute granularity")
+	}
+
+	s := &Schedule{
+		Minute: bitset(e.Minutes),
+		Hour:   bitset(e.Hours),
+		Dom:    bitset(e.MonthDays),
+		Month:  bitset(e.Months),
+		Dow:    bitset(e.WeekDays),
+	}
+
+	if e.MonthDaysWildcard {
+		s.Dom |= starBit
+	}
+	if e.WeekDaysWildcard {
+		s.Dow |= starBit
+	}
+
+	return s, nil
+}
+
+// schedulePlaceholderCommand stands in for the command field ParseExpression
+// requires, since a bare schedule (as registered with Cron.Register) has
+// none of its own.
+const schedulePlaceholderCommand = "-"
+
+// ParseSchedule parses the schedule fields of line (minute hour
+// day-of-month month day-of-week, or a descriptor like `@daily` in their
+// place) and compiles them into a Schedule. Unlike ParseExpression it
+// does not expect a trailing command. It shares ParseExpression's parser, so
+// month/weekday names, `@` descriptors and `?` are all accepted here too.
+func ParseSchedule(line string) (*Schedule, error) {
+	e, err := ParseExpression(strings.TrimSpace(line) + " " + schedulePlaceholderCommand)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse schedule: %w", err)
+	}
+
+	if e.EveryDuration > 0 {
+		return nil, errors.New("@every is not representable as a Schedule; use Cron.RegisterJob with your own ticking Job instead")
+	}
+
+	return NewSchedule(e)
+}
+
+func bitset(values []uint8) uint64 {
+	var bits uint64
+	for _, v := range values {
+		bits |= 1 << uint(v)
+	}
+	return bits
+}
+
+// yearsAhead bounds how far Next/Prev will search before giving up, mirroring
+// robfig/cron's 5-year search limit.
+const yearsAhead = 5
+
+// Next returns the earliest time strictly after t that matches the schedule,
+// or the zero time if none is found within yearsAhead years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Add(time.Minute)
+	t = t.Truncate(time.Minute)
+	yearLimit := t.Year() + yearsAhead
+
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.Month == 0 {
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.Hour == 0 {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.Minute == 0 {
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	return t
+}
+
+// Prev returns the latest time strictly before t that matches the schedule,
+// or the zero time if none is found within yearsAhead years.
+func (s *Schedule) Prev(t time.Time) time.Time {
+	t = t.Truncate(time.Minute)
+	t = t.Add(-time.Minute)
+	yearLimit := t.Year() - yearsAhead
+
+wrap:
+	if t.Year() < yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.Month == 0 {
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+		if t.Month() == time.December {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+		if t.Day() == daysInLastDayOf(t) {
+			goto wrap
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.Hour == 0 {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 59, 0, 0, t.Location()).Add(-time.Hour)
+		if t.Hour() == 23 {
+			goto wrap
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.Minute == 0 {
+		t = t.Add(-time.Minute)
+		if t.Minute() == 59 {
+			goto wrap
+		}
+	}
+
+	return t
+}
+
+// daysInLastDayOf returns the day-of-month of the last day of t's month,
+// used by Prev to detect month rollover after walking backwards a day at a
+// time.
+func daysInLastDayOf(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// dayMatches reports whether t's day-of-month and day-of-week satisfy the
+// schedule. Vixie cron combines the two fields with OR when both are
+// constrained, and with the usual AND otherwise.
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := 1<<uint(t.Day())&s.Dom > 0
+	dowMatch := 1<<uint(t.Weekday())&s.Dow > 0
+
+	if s.Dom&starBit > 0 || s.Dow&starBit > 0 {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
@@ -0,0 +1,159 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func mustSchedule(t *testing.T, expr string) *Schedule {
+	t.Helper()
+
+	s, err := ParseSchedule(expr)
+	if err != nil {
+		t.Fatalf("could not parse schedule %q: %v", expr, err)
+	}
+
+	return s
+}
+
+func TestNewScheduleRejectsSeconds(t *testing.T) {
+	p := NewParser(Second | Minute | Hour | Dom | Month | Dow)
+
+	e, err := p.Parse("30 0 0 * * * echo hi")
+	if err != nil {
+		t.Fatalf("could not parse expression: %v", err)
+	}
+
+	if _, err := NewSchedule(e); err == nil {
+		t.Fatal("expected NewSchedule to reject an Expression with Seconds set")
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	cases := []struct {
+		name     string
+		schedule string
+		from     string
+		expected string
+	}{
+		{
+			name:     "every minute",
+			schedule: "* * * * *",
+			from:     "2020-01-01T00:00:00Z",
+			expected: "2020-01-01T00:01:00Z",
+		},
+		{
+			name:     "every hour on the hour",
+			schedule: "0 * * * *",
+			from:     "2020-01-01T00:30:00Z",
+			expected: "2020-01-01T01:00:00Z",
+		},
+		{
+			name:     "rolls into next day",
+			schedule: "0 0 * * *",
+			from:     "2020-01-01T23:59:00Z",
+			expected: "2020-01-02T00:00:00Z",
+		},
+		{
+			name:     "rolls into next month",
+			schedule: "0 0 1 * *",
+			from:     "2020-01-15T00:00:00Z",
+			expected: "2020-02-01T00:00:00Z",
+		},
+		{
+			name:     "clamps february",
+			schedule: "0 0 30 * *",
+			from:     "2020-02-01T00:00:00Z",
+			expected: "2020-03-30T00:00:00Z",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			from, err := time.Parse(time.RFC3339, c.from)
+			if err != nil {
+				t.Fatalf("could not parse from: %v", err)
+			}
+
+			want, err := time.Parse(time.RFC3339, c.expected)
+			if err != nil {
+				t.Fatalf("could not parse expected: %v", err)
+			}
+
+			got := mustSchedule(t, c.schedule).Next(from)
+			if !got.Equal(want) {
+				t.Fatalf("Next(%s) = %s; want %s", from, got, want)
+			}
+		})
+	}
+}
+
+func TestSchedulePrev(t *testing.T) {
+	schedule := mustSchedule(t, "0 0 * * *")
+
+	from, err := time.Parse(time.RFC3339, "2020-01-02T00:30:00Z")
+	if err != nil {
+		t.Fatalf("could not parse from: %v", err)
+	}
+
+	want, err := time.Parse(time.RFC3339, "2020-01-02T00:00:00Z")
+	if err != nil {
+		t.Fatalf("could not parse expected: %v", err)
+	}
+
+	got := schedule.Prev(from)
+	if !got.Equal(want) {
+		t.Fatalf("Prev(%s) = %s; want %s", from, got, want)
+	}
+}
+
+func TestScheduleDayMatchesCombinesWithOr(t *testing.T) {
+	// day 1 OR Sunday: both constrained, so Vixie cron ORs them.
+	schedule := mustSchedule(t, "0 0 1 * 0")
+
+	sunday, err := time.Parse(time.RFC3339, "2020-01-05T00:00:00Z")
+	if err != nil {
+		t.Fatalf("could not parse sunday: %v", err)
+	}
+
+	if !schedule.dayMatches(sunday) {
+		t.Fatalf("expected %s (a Sunday) to match day-of-month OR day-of-week", sunday)
+	}
+}
+
+func TestScheduleDayMatchesExplicitFullRangeIsNotWildcard(t *testing.T) {
+	// "0-7" spans every weekday value the same way "*" would, but it was
+	// written explicitly: dom and dow are both constrained, so Vixie cron
+	// still ORs them, rather than mistaking the full span for "day-of-week
+	// unconstrained" and ANDing instead. Since the dow side always matches,
+	// the OR makes every day match regardless of dom.
+	schedule := mustSchedule(t, "0 0 15 * 0-7")
+
+	notTheFifteenth, err := time.Parse(time.RFC3339, "2020-01-05T00:00:00Z")
+	if err != nil {
+		t.Fatalf("could not parse date: %v", err)
+	}
+
+	if !schedule.dayMatches(notTheFifteenth) {
+		t.Fatalf("expected %s to match via the OR with an explicit dow=0-7", notTheFifteenth)
+	}
+}
+
+func TestScheduleDayMatchesExplicitFullDomRangeIsNotWildcard(t *testing.T) {
+	// Same bug, mirrored: an explicit "1-31" dom spans the whole month just
+	// like "*" would, but it must still OR with a constrained dow rather
+	// than be mistaken for "day-of-month unconstrained" and AND instead.
+	schedule := mustSchedule(t, "0 0 1-31 * 0")
+
+	notASunday, err := time.Parse(time.RFC3339, "2020-01-06T00:00:00Z")
+	if err != nil {
+		t.Fatalf("could not parse date: %v", err)
+	}
+
+	if !schedule.dayMatches(notASunday) {
+		t.Fatalf("expected %s to match via the OR with an explicit dom=1-31", notASunday)
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"strings"
@@ -12,7 +14,15 @@ func main() {
 	log.SetFlags(0)
 	log.SetOutput(os.Stderr)
 
-	args := os.Args[1:]
+	file := flag.String("f", "", "parse a Vixie-style crontab file and dump every entry")
+	flag.Parse()
+
+	if *file != "" {
+		dumpCrontabFile(*file)
+		return
+	}
+
+	args := flag.Args()
 	if len(args) == 0 {
 		log.Fatal("no input provided")
 	}
@@ -29,3 +39,20 @@ func main() {
 		log.Fatalf("could not dump cron expression: %v", err)
 	}
 }
+
+func dumpCrontabFile(path string) {
+	entries, _, err := cron.ParseCrontabFile(path)
+	if err != nil {
+		log.Fatalf("could not parse crontab file: %v", err)
+	}
+
+	for i, entry := range entries {
+		if i > 0 {
+			fmt.Fprintln(os.Stdout)
+		}
+
+		if err := entry.Expression.DumpFormatted(os.Stdout); err != nil {
+			log.Fatalf("could not dump cron expression at line %d: %v", entry.Line, err)
+		}
+	}
+}